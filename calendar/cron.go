@@ -0,0 +1,140 @@
+package calendar
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// cronMacros are the non-standard but widely supported "@"-prefixed cron
+// shorthands, expanded to their 5-field equivalent before parsing.
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// ParseCron parses a standard 5-field cron expression (minute hour
+// day-of-month month day-of-week), one of the "@daily"/"@hourly"-style
+// macros, or a 6-field expression whose leading field is seconds.
+//
+// "*" means all values, "a-b" a range, "*/n" a step, and "a,b,c" a list of
+// any of the above. Day-of-week 0 and 7 both mean Sunday. When both
+// day-of-month and day-of-week are restricted, the two are unioned
+// (Vixie cron convention) rather than intersected.
+func ParseCron(expr string) (*Event, error) {
+	original := expr
+	expr = strings.TrimSpace(expr)
+	if macro, ok := cronMacros[expr]; ok {
+		expr = macro
+	}
+
+	fields := strings.Fields(expr)
+
+	var secondsField string
+	switch len(fields) {
+	case 5:
+		// minute hour day-of-month month day-of-week
+	case 6:
+		secondsField, fields = fields[0], fields[1:]
+	default:
+		return nil, fmt.Errorf("cron expression %q: expected 5 or 6 fields, got %d", original, len(fields))
+	}
+
+	event := NewEvent(original)
+	event.unionDayFields = true
+
+	if secondsField != "" {
+		if err := parseCronField(secondsField, 0, 59, &event.Second); err != nil {
+			return nil, fmt.Errorf("cron expression %q: seconds field: %w", original, err)
+		}
+	}
+	if err := parseCronField(fields[0], 0, 59, &event.Minute); err != nil {
+		return nil, fmt.Errorf("cron expression %q: minute field: %w", original, err)
+	}
+	if err := parseCronField(fields[1], 0, 23, &event.Hour); err != nil {
+		return nil, fmt.Errorf("cron expression %q: hour field: %w", original, err)
+	}
+	if err := parseCronField(fields[2], 1, 31, &event.Day); err != nil {
+		return nil, fmt.Errorf("cron expression %q: day-of-month field: %w", original, err)
+	}
+	if err := parseCronField(fields[3], 1, 12, &event.Month); err != nil {
+		return nil, fmt.Errorf("cron expression %q: month field: %w", original, err)
+	}
+	if err := parseWeekDayField(fields[4], &event.WeekDay); err != nil {
+		return nil, fmt.Errorf("cron expression %q: day-of-week field: %w", original, err)
+	}
+	return event, nil
+}
+
+// parseCronField parses a single comma-separated cron field into value,
+// validating each part against [min, max].
+func parseCronField(field string, min, max int, value *Value) error {
+	*value = Value{}
+	for _, part := range strings.Split(field, ",") {
+		if err := parseCronFieldPart(part, min, max, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseCronFieldPart(part string, min, max int, value *Value) error {
+	rangePart, step := part, 1
+	if i := strings.IndexByte(part, '/'); i >= 0 {
+		rangePart = part[:i]
+		n, err := strconv.Atoi(part[i+1:])
+		if err != nil || n <= 0 {
+			return fmt.Errorf("invalid step in %q", part)
+		}
+		step = n
+	}
+
+	switch {
+	case rangePart == "*":
+		// an unstepped wildcard leaves the field unrestricted
+		if step > 1 {
+			value.addRange(min, max, step)
+		}
+		return nil
+
+	case strings.Contains(rangePart, "-"):
+		bounds := strings.SplitN(rangePart, "-", 2)
+		start, errStart := strconv.Atoi(bounds[0])
+		end, errEnd := strconv.Atoi(bounds[1])
+		if errStart != nil || errEnd != nil || start < min || end > max || start > end {
+			return fmt.Errorf("invalid range %q", part)
+		}
+		value.addRange(start, end, step)
+		return nil
+
+	default:
+		n, err := strconv.Atoi(rangePart)
+		if err != nil || n < min || n > max {
+			return fmt.Errorf("invalid value %q", part)
+		}
+		if step > 1 {
+			// systemd-style "start/step", e.g. "0/15": step from start to max
+			value.addRange(n, max, step)
+			return nil
+		}
+		value.addValue(n)
+		return nil
+	}
+}
+
+// parseWeekDayField parses a cron day-of-week field, folding the 7
+// (Sunday) alias into 0 so it matches time.Weekday.
+func parseWeekDayField(field string, value *Value) error {
+	if err := parseCronField(field, 0, 7, value); err != nil {
+		return err
+	}
+	if value.HasValue() && value.Contains(7) {
+		value.addValue(0)
+	}
+	return nil
+}
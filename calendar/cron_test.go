@@ -0,0 +1,132 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+// TestCronMatchesSystemdCalendar checks that a cron expression and its
+// systemd calendar equivalent produce the exact same set of fire times.
+func TestCronMatchesSystemdCalendar(t *testing.T) {
+	testCases := []struct {
+		name    string
+		cron    string
+		systemd string
+	}{
+		{"daily-at-time", "30 3 * * *", "*-*-* 03:30:00"},
+		{"macro-daily", "@daily", "daily"},
+		{"macro-hourly", "@hourly", "hourly"},
+		{"weekly-monday", "0 9 * * 1", "Mon *-*-* 09:00:00"},
+		{"minute-step", "*/15 * * * *", "*:0/15"},
+		{"time-of-day", "03:30", "*-*-* 03:30:00"},
+	}
+
+	start := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 14)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			cronEvent, err := ParseSchedule(testCase.cron)
+			if err != nil {
+				t.Fatalf("parsing cron %q: %v", testCase.cron, err)
+			}
+			systemdEvent, err := ParseSchedule(testCase.systemd)
+			if err != nil {
+				t.Fatalf("parsing systemd calendar %q: %v", testCase.systemd, err)
+			}
+
+			cronOccurrences := cronEvent.GetAllInBetween(start, end)
+			systemdOccurrences := systemdEvent.GetAllInBetween(start, end)
+
+			if len(cronOccurrences) == 0 {
+				t.Fatalf("cron %q produced no occurrences", testCase.cron)
+			}
+			if len(cronOccurrences) != len(systemdOccurrences) {
+				t.Fatalf("expected %d occurrences, got %d", len(systemdOccurrences), len(cronOccurrences))
+			}
+			for i := range cronOccurrences {
+				if !cronOccurrences[i].Equal(systemdOccurrences[i]) {
+					t.Errorf("occurrence %d: cron=%s systemd=%s", i, cronOccurrences[i], systemdOccurrences[i])
+				}
+			}
+
+			if next := cronEvent.Next(start); !next.Equal(systemdEvent.Next(start)) {
+				t.Errorf("Next: cron=%s systemd=%s", next, systemdEvent.Next(start))
+			}
+		})
+	}
+}
+
+// TestParseCronRejectsInvalidFieldCount ensures malformed expressions are
+// rejected rather than silently misparsed.
+func TestParseCronRejectsInvalidFieldCount(t *testing.T) {
+	if _, err := ParseCron("* * * *"); err == nil {
+		t.Error("expected an error for a 4-field cron expression")
+	}
+}
+
+// TestParseScheduleSixFieldSeconds verifies that a 6-field cron
+// expression is parsed with sub-minute granularity.
+func TestParseScheduleSixFieldSeconds(t *testing.T) {
+	event, err := ParseSchedule("30 */5 * * * *")
+	if err != nil {
+		t.Fatalf("parsing 6-field cron: %v", err)
+	}
+	if !event.Second.HasValue() {
+		t.Error("expected the seconds field to be restricted")
+	}
+}
+
+// TestGetAllInBetweenMultipleSeconds verifies that every value of a
+// comma-list or step in the seconds field produces its own occurrence,
+// rather than only the first one.
+func TestGetAllInBetweenMultipleSeconds(t *testing.T) {
+	event, err := ParseCron("0,30 * * * * *")
+	if err != nil {
+		t.Fatalf("parsing 6-field cron: %v", err)
+	}
+
+	start := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Minute)
+
+	occurrences := event.GetAllInBetween(start, end)
+	expected := []time.Time{
+		start,
+		start.Add(30 * time.Second),
+		start.Add(1 * time.Minute),
+		start.Add(1*time.Minute + 30*time.Second),
+	}
+	if len(occurrences) != len(expected) {
+		t.Fatalf("expected %d occurrences, got %d: %v", len(expected), len(occurrences), occurrences)
+	}
+	for i, want := range expected {
+		if !occurrences[i].Equal(want) {
+			t.Errorf("occurrence %d: want %s, got %s", i, want, occurrences[i])
+		}
+	}
+}
+
+// TestNextWithinSameMinute verifies that Next doesn't jump a full minute
+// ahead when a later second-value in from's own minute already satisfies
+// a seconds-restricted event.
+func TestNextWithinSameMinute(t *testing.T) {
+	event, err := ParseCron("0,30 * * * * *")
+	if err != nil {
+		t.Fatalf("parsing 6-field cron: %v", err)
+	}
+
+	from := time.Date(2026, time.January, 1, 0, 0, 15, 0, time.UTC)
+	want := time.Date(2026, time.January, 1, 0, 0, 30, 0, time.UTC)
+
+	if next := event.Next(from); !next.Equal(want) {
+		t.Errorf("Next(%s) = %s, want %s", from, next, want)
+	}
+
+	// from exactly on a candidate second: Next must still advance,
+	// never returning from itself.
+	from = want
+	want = time.Date(2026, time.January, 1, 0, 1, 0, 0, time.UTC)
+	if next := event.Next(from); !next.Equal(want) {
+		t.Errorf("Next(%s) = %s, want %s", from, next, want)
+	}
+}
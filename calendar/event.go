@@ -0,0 +1,163 @@
+package calendar
+
+import "time"
+
+// maxLookAhead bounds how far into the future Next and GetAllInBetween
+// will search for a match, so a schedule that can never fire doesn't spin
+// forever.
+const maxLookAhead = 4 * 365 * 24 * time.Hour
+
+// Event represents a normalised recurring (or one-shot) schedule, built
+// either from a systemd calendar expression or from a cron expression.
+// Backends (schtasks, systemd timers, launchd plists) only ever deal with
+// this representation, so none of them need to know which syntax the user
+// typed.
+type Event struct {
+	Second, Minute, Hour, Day, Month, WeekDay Value
+
+	// unionDayFields follows the Vixie cron convention: when both Day and
+	// WeekDay are restricted, a match on either is enough. Systemd
+	// calendar expressions always intersect the two instead.
+	unionDayFields bool
+
+	// year and hasYear hold a concrete calendar year parsed from a dated
+	// systemd expression ("2026-08-01 ..."), before the full expression
+	// is known to describe a single instant and fixedTime can be built.
+	year    int
+	hasYear bool
+
+	fixedTime *time.Time
+	source    string
+}
+
+// NewEvent creates an empty (unrestricted, matches every minute) Event,
+// remembering source as the original expression for String().
+func NewEvent(source string) *Event {
+	return &Event{source: source}
+}
+
+// String returns the original expression this Event was parsed from.
+func (e *Event) String() string {
+	return e.source
+}
+
+// AsTime returns the fixed point in time this Event fires at, if it
+// represents a one-shot schedule rather than a recurring one.
+func (e *Event) AsTime() (time.Time, bool) {
+	if e.fixedTime == nil {
+		return time.Time{}, false
+	}
+	return *e.fixedTime, true
+}
+
+// IsDaily returns true if this Event fires on every day (it's not
+// restricted to specific days of the month, months, or weekdays).
+func (e *Event) IsDaily() bool {
+	return !e.Day.HasValue() && !e.Month.HasValue() && !e.WeekDay.HasValue()
+}
+
+// IsWeekly returns true if this Event is restricted to specific weekdays,
+// and not to specific days of the month or months.
+func (e *Event) IsWeekly() bool {
+	return e.WeekDay.HasValue() && !e.Day.HasValue() && !e.Month.HasValue()
+}
+
+// IsMonthly returns true if this Event is restricted to specific days of
+// the month or specific months.
+func (e *Event) IsMonthly() bool {
+	return e.Day.HasValue() || e.Month.HasValue()
+}
+
+// Next returns the next time this Event fires strictly after from, or the
+// zero time.Time if it never fires within the look-ahead window.
+func (e *Event) Next(from time.Time) time.Time {
+	if fixed, ok := e.AsTime(); ok {
+		if fixed.After(from) {
+			return fixed
+		}
+		return time.Time{}
+	}
+
+	t := from.Truncate(time.Minute)
+	limit := from.Add(maxLookAhead)
+	if e.matches(t) {
+		for _, candidate := range e.resolveSeconds(t) {
+			if candidate.After(from) {
+				return candidate
+			}
+		}
+	}
+	t = t.Add(time.Minute)
+
+	for t.Before(limit) {
+		if e.matches(t) {
+			// every candidate second in this minute is after from, since
+			// t itself already is
+			return e.resolveSeconds(t)[0]
+		}
+		t = t.Add(time.Minute)
+	}
+	return time.Time{}
+}
+
+// GetAllInBetween returns every time this Event fires in [start, end).
+func (e *Event) GetAllInBetween(start, end time.Time) []time.Time {
+	var occurrences []time.Time
+
+	if fixed, ok := e.AsTime(); ok {
+		if !fixed.Before(start) && fixed.Before(end) {
+			occurrences = append(occurrences, fixed)
+		}
+		return occurrences
+	}
+
+	t := start.Truncate(time.Minute)
+	for t.Before(end) {
+		if e.matches(t) {
+			for _, candidate := range e.resolveSeconds(t) {
+				if !candidate.Before(start) && candidate.Before(end) {
+					occurrences = append(occurrences, candidate)
+				}
+			}
+		}
+		t = t.Add(time.Minute)
+	}
+	return occurrences
+}
+
+// matches returns true if t (at second 0) satisfies every restricted
+// field of the Event.
+func (e *Event) matches(t time.Time) bool {
+	if !e.Minute.Contains(t.Minute()) {
+		return false
+	}
+	if !e.Hour.Contains(t.Hour()) {
+		return false
+	}
+	if !e.Month.Contains(int(t.Month())) {
+		return false
+	}
+
+	dayOK := !e.Day.HasValue() || e.Day.Contains(t.Day())
+	weekDayOK := !e.WeekDay.HasValue() || e.WeekDay.Contains(int(t.Weekday()))
+
+	if e.unionDayFields && e.Day.HasValue() && e.WeekDay.HasValue() {
+		return dayOK || weekDayOK
+	}
+	return dayOK && weekDayOK
+}
+
+// resolveSeconds expands t (a matching minute, at :00) into one
+// candidate time per accepted value of Second, sorted ascending, or
+// just t itself if Second is unrestricted.
+func (e *Event) resolveSeconds(t time.Time) []time.Time {
+	if !e.Second.HasValue() {
+		return []time.Time{t}
+	}
+	seconds := e.Second.GetRangeValues()
+	times := make([]time.Time, len(seconds))
+	for i, second := range seconds {
+		times[i] = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), second, 0, t.Location())
+	}
+	return times
+}
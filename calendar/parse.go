@@ -0,0 +1,49 @@
+package calendar
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var timeOfDayPattern = regexp.MustCompile(`^([01]?\d|2[0-3]):([0-5]\d)$`)
+
+// ParseSchedule parses a schedule expression written in any of the
+// syntaxes resticprofile accepts: a systemd calendar expression, a
+// standard cron expression (5 fields, 6 with a leading seconds field, or
+// an "@daily"-style macro), or a bare "HH:MM" time of day, which expands
+// to the equivalent daily cron entry. The result is always a normalised
+// calendar.Event, so none of the platform schedule generators need to
+// care which syntax the user typed.
+func ParseSchedule(expr string) (*Event, error) {
+	text := strings.TrimSpace(expr)
+	if text == "" {
+		return nil, fmt.Errorf("empty schedule")
+	}
+
+	if m := timeOfDayPattern.FindStringSubmatch(text); m != nil {
+		event, err := ParseCron(fmt.Sprintf("%s %s * * *", m[2], m[1]))
+		if err != nil {
+			return nil, err
+		}
+		event.source = expr
+		return event, nil
+	}
+
+	if looksLikeCron(text) {
+		return ParseCron(text)
+	}
+	return ParseSystemdCalendar(text)
+}
+
+// looksLikeCron reports whether expr is a cron macro or splits into the
+// 5 or 6 whitespace-separated fields of a cron expression. A systemd
+// calendar expression has at most three fields (weekday, date and time),
+// so an expression with that field count can only be cron.
+func looksLikeCron(expr string) bool {
+	if _, ok := cronMacros[expr]; ok {
+		return true
+	}
+	fields := strings.Fields(expr)
+	return len(fields) == 5 || len(fields) == 6
+}
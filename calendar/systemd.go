@@ -0,0 +1,215 @@
+package calendar
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// systemdKeywords are the systemd calendar shorthands, expanded to their
+// "date time" equivalent before parsing.
+var systemdKeywords = map[string]string{
+	"minutely":     "*-*-* *:*:00",
+	"hourly":       "*-*-* *:00:00",
+	"daily":        "*-*-* 00:00:00",
+	"midnight":     "*-*-* 00:00:00",
+	"weekly":       "Mon *-*-* 00:00:00",
+	"monthly":      "*-*-01 00:00:00",
+	"quarterly":    "*-01,04,07,10-01 00:00:00",
+	"semiannually": "*-01,07-01 00:00:00",
+	"yearly":       "*-01-01 00:00:00",
+	"annually":     "*-01-01 00:00:00",
+}
+
+var weekdayNames = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+// ParseSystemdCalendar parses a systemd OnCalendar-style expression
+// ("*-*-* 03:30:00", "Mon,Fri 09:00", "daily", ...) into an Event.
+func ParseSystemdCalendar(expr string) (*Event, error) {
+	original := expr
+	text := strings.TrimSpace(expr)
+	if keyword, ok := systemdKeywords[strings.ToLower(text)]; ok {
+		text = keyword
+	}
+
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("systemd calendar expression %q: empty", original)
+	}
+
+	event := NewEvent(original)
+
+	if weekDays, ok := parseSystemdWeekdays(fields[0]); ok {
+		event.WeekDay = weekDays
+		fields = fields[1:]
+	}
+
+	var dateField, timeField string
+	switch len(fields) {
+	case 0:
+		return nil, fmt.Errorf("systemd calendar expression %q: missing date/time", original)
+	case 1:
+		if strings.Contains(fields[0], ":") {
+			timeField = fields[0]
+		} else {
+			dateField = fields[0]
+		}
+	case 2:
+		dateField, timeField = fields[0], fields[1]
+	default:
+		return nil, fmt.Errorf("systemd calendar expression %q: too many fields", original)
+	}
+
+	if dateField != "" && dateField != "*" {
+		if err := parseSystemdDate(dateField, event); err != nil {
+			return nil, fmt.Errorf("systemd calendar expression %q: %w", original, err)
+		}
+	}
+
+	if timeField != "" && timeField != "*" {
+		if err := parseSystemdTime(timeField, event); err != nil {
+			return nil, fmt.Errorf("systemd calendar expression %q: %w", original, err)
+		}
+	}
+
+	if event.hasYear {
+		fixed, err := resolveFixedTime(event)
+		if err != nil {
+			return nil, fmt.Errorf("systemd calendar expression %q: %w", original, err)
+		}
+		event.fixedTime = &fixed
+		event.Month = Value{}
+		event.Day = Value{}
+		event.Hour = Value{}
+		event.Minute = Value{}
+		event.Second = Value{}
+	}
+
+	return event, nil
+}
+
+// parseSystemdDate parses the "year-month-day" part of a calendar
+// expression. A leading year field, since Event has no standalone year
+// restriction, is only accepted when the rest of the expression narrows
+// down to a single instant (see resolveFixedTime); otherwise the
+// expression is rejected rather than silently reinterpreted as an
+// annual recurrence.
+func parseSystemdDate(field string, event *Event) error {
+	parts := strings.Split(field, "-")
+	var yearField, monthField, dayField string
+	switch len(parts) {
+	case 3:
+		yearField, monthField, dayField = parts[0], parts[1], parts[2]
+	case 2:
+		monthField, dayField = parts[0], parts[1]
+	default:
+		return fmt.Errorf("invalid date %q", field)
+	}
+	if yearField != "" && yearField != "*" {
+		year, err := strconv.Atoi(yearField)
+		if err != nil {
+			return fmt.Errorf("year %q: a year must be a single 4-digit value", yearField)
+		}
+		event.year = year
+		event.hasYear = true
+	}
+	if err := parseCronField(monthField, 1, 12, &event.Month); err != nil {
+		return fmt.Errorf("month: %w", err)
+	}
+	if err := parseCronField(dayField, 1, 31, &event.Day); err != nil {
+		return fmt.Errorf("day: %w", err)
+	}
+	if event.hasYear {
+		if _, ok := event.Month.singleValue(); !ok {
+			return fmt.Errorf("year %d: month must be a single value, not a range or list", event.year)
+		}
+		if _, ok := event.Day.singleValue(); !ok {
+			return fmt.Errorf("year %d: day must be a single value, not a range or list", event.year)
+		}
+	}
+	return nil
+}
+
+// resolveFixedTime builds the single instant a year-scoped calendar
+// expression describes. It requires the hour, minute and second (any
+// that were specified) to each be a single value too, since a year
+// bounds the expression to one point in time, not a recurring one.
+func resolveFixedTime(event *Event) (time.Time, error) {
+	month, _ := event.Month.singleValue()
+	day, _ := event.Day.singleValue()
+
+	hour, minute, second := 0, 0, 0
+	if event.Hour.HasValue() {
+		h, ok := event.Hour.singleValue()
+		if !ok {
+			return time.Time{}, fmt.Errorf("year %d: hour must be a single value, not a range or list", event.year)
+		}
+		hour = h
+	}
+	if event.Minute.HasValue() {
+		m, ok := event.Minute.singleValue()
+		if !ok {
+			return time.Time{}, fmt.Errorf("year %d: minute must be a single value, not a range or list", event.year)
+		}
+		minute = m
+	}
+	if event.Second.HasValue() {
+		s, ok := event.Second.singleValue()
+		if !ok {
+			return time.Time{}, fmt.Errorf("year %d: second must be a single value, not a range or list", event.year)
+		}
+		second = s
+	}
+
+	return time.Date(event.year, time.Month(month), day, hour, minute, second, 0, time.Local), nil
+}
+
+// parseSystemdTime parses the "hour:minute[:second]" part of a calendar
+// expression.
+func parseSystemdTime(field string, event *Event) error {
+	parts := strings.Split(field, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return fmt.Errorf("invalid time %q", field)
+	}
+	if err := parseCronField(parts[0], 0, 23, &event.Hour); err != nil {
+		return fmt.Errorf("hour: %w", err)
+	}
+	if err := parseCronField(parts[1], 0, 59, &event.Minute); err != nil {
+		return fmt.Errorf("minute: %w", err)
+	}
+	if len(parts) == 3 {
+		if err := parseCronField(parts[2], 0, 59, &event.Second); err != nil {
+			return fmt.Errorf("second: %w", err)
+		}
+	}
+	return nil
+}
+
+// parseSystemdWeekdays parses a leading weekday field such as "Mon",
+// "Mon,Wed,Fri" or "Mon..Fri". It returns false if field doesn't look
+// like a weekday list, so the caller can treat it as the date field
+// instead.
+func parseSystemdWeekdays(field string) (Value, bool) {
+	var value Value
+	for _, part := range strings.Split(field, ",") {
+		part = strings.ToLower(strings.TrimSpace(part))
+		if i := strings.Index(part, ".."); i >= 0 {
+			start, ok1 := weekdayNames[part[:i]]
+			end, ok2 := weekdayNames[part[i+2:]]
+			if !ok1 || !ok2 {
+				return Value{}, false
+			}
+			value.addRange(start, end, 1)
+			continue
+		}
+		day, ok := weekdayNames[part]
+		if !ok {
+			return Value{}, false
+		}
+		value.addValue(day)
+	}
+	return value, true
+}
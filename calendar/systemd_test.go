@@ -0,0 +1,73 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParseSystemdCalendarDatedExpression verifies that a year-scoped
+// expression is treated as a one-shot schedule rather than silently
+// narrowed into an annual recurrence.
+func TestParseSystemdCalendarDatedExpression(t *testing.T) {
+	event, err := ParseSystemdCalendar("2026-08-01 10:30:00")
+	if err != nil {
+		t.Fatalf("ParseSystemdCalendar: %v", err)
+	}
+
+	fixed, ok := event.AsTime()
+	if !ok {
+		t.Fatal("expected a dated expression to report AsTime, ok = true")
+	}
+	want := time.Date(2026, time.August, 1, 10, 30, 0, 0, time.Local)
+	if !fixed.Equal(want) {
+		t.Errorf("AsTime() = %s, want %s", fixed, want)
+	}
+
+	if event.Month.HasValue() || event.Day.HasValue() {
+		t.Error("expected Month/Day to be cleared once folded into fixedTime")
+	}
+
+	before := want.Add(-time.Minute)
+	if next := event.Next(before); !next.Equal(want) {
+		t.Errorf("Next(%s) = %s, want %s", before, next, want)
+	}
+	if next := event.Next(want); !next.IsZero() {
+		t.Errorf("Next(%s) = %s, want the zero time (no second occurrence)", want, next)
+	}
+}
+
+// TestParseSystemdCalendarDatedExpressionRejectsRanges verifies that a
+// year combined with a non-single month/day/time component is rejected
+// outright instead of silently picking one value or discarding the
+// year.
+func TestParseSystemdCalendarDatedExpressionRejectsRanges(t *testing.T) {
+	if _, err := ParseSystemdCalendar("2026-01,02-01 10:00:00"); err == nil {
+		t.Error("expected a year combined with a month list to be rejected")
+	}
+	if _, err := ParseSystemdCalendar("2026-08-01 10,11:00:00"); err == nil {
+		t.Error("expected a year combined with an hour list to be rejected")
+	}
+	if _, err := ParseSystemdCalendar("2026,2027-08-01 10:00:00"); err == nil {
+		t.Error("expected a list of years to be rejected")
+	}
+}
+
+// TestParseSystemdCalendarYearlessExpressionStillRecurs verifies that an
+// expression with no year field still recurs annually, unaffected by the
+// dated-expression handling above.
+func TestParseSystemdCalendarYearlessExpressionStillRecurs(t *testing.T) {
+	event, err := ParseSystemdCalendar("*-08-01 10:00:00")
+	if err != nil {
+		t.Fatalf("ParseSystemdCalendar: %v", err)
+	}
+	if _, ok := event.AsTime(); ok {
+		t.Error("expected a yearless expression to not be a one-shot schedule")
+	}
+
+	from := time.Date(2026, time.August, 2, 0, 0, 0, 0, time.Local)
+	next := event.Next(from)
+	want := time.Date(2027, time.August, 1, 10, 0, 0, 0, time.Local)
+	if !next.Equal(want) {
+		t.Errorf("Next(%s) = %s, want %s", from, next, want)
+	}
+}
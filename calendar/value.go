@@ -0,0 +1,66 @@
+package calendar
+
+import "sort"
+
+// Value represents the set of values accepted for one field of a calendar
+// Event (minute, hour, day, weekday or month). An empty Value matches any
+// value, which is how a wildcard field ("*" in a cron or systemd calendar
+// expression) is represented.
+type Value struct {
+	values map[int]bool
+}
+
+// HasValue returns true if this field has been restricted to a specific
+// set of values (as opposed to matching anything).
+func (v Value) HasValue() bool {
+	return len(v.values) > 0
+}
+
+// Contains returns true if n is an accepted value for this field. An
+// unrestricted Value accepts any value.
+func (v Value) Contains(n int) bool {
+	if len(v.values) == 0 {
+		return true
+	}
+	return v.values[n]
+}
+
+// GetRangeValues returns the sorted list of accepted values, or an empty
+// slice if the field is unrestricted.
+func (v Value) GetRangeValues() []int {
+	values := make([]int, 0, len(v.values))
+	for value := range v.values {
+		values = append(values, value)
+	}
+	sort.Ints(values)
+	return values
+}
+
+// singleValue returns the sole accepted value and true if this field has
+// been restricted to exactly one value, as opposed to a wildcard, a
+// range, or a list of several values.
+func (v Value) singleValue() (int, bool) {
+	if len(v.values) != 1 {
+		return 0, false
+	}
+	for value := range v.values {
+		return value, true
+	}
+	return 0, false
+}
+
+func (v *Value) addValue(n int) {
+	if v.values == nil {
+		v.values = make(map[int]bool)
+	}
+	v.values[n] = true
+}
+
+func (v *Value) addRange(start, end, step int) {
+	if step <= 0 {
+		step = 1
+	}
+	for i := start; i <= end; i += step {
+		v.addValue(i)
+	}
+}
@@ -0,0 +1,63 @@
+package clog
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONLogWritesOneObjectPerLine(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewJSONLog(&buf)
+
+	logger.Info("hello")
+	logger.Errorf("boom %d", 42)
+
+	lines := bytes.Split(bytes.TrimRight(buf.Bytes(), "\n"), []byte("\n"))
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d: %s", len(lines), buf.String())
+	}
+
+	var first jsonEntry
+	if err := json.Unmarshal(lines[0], &first); err != nil {
+		t.Fatalf("unmarshalling first line: %v", err)
+	}
+	if first.Msg != "hello" || first.Level != "info" {
+		t.Errorf("unexpected first entry: %+v", first)
+	}
+
+	var second jsonEntry
+	if err := json.Unmarshal(lines[1], &second); err != nil {
+		t.Fatalf("unmarshalling second line: %v", err)
+	}
+	if second.Msg != "boom 42" || second.Level != "error" {
+		t.Errorf("unexpected second entry: %+v", second)
+	}
+}
+
+func TestJSONLogWithAttachesFields(t *testing.T) {
+	var buf bytes.Buffer
+	base := NewJSONLog(&buf)
+	withProfile := base.With("profile", "prod")
+
+	withProfile.Info("hello")
+
+	var entry jsonEntry
+	if err := json.Unmarshal(bytes.TrimRight(buf.Bytes(), "\n"), &entry); err != nil {
+		t.Fatalf("unmarshalling entry: %v", err)
+	}
+	if entry.Fields["profile"] != "prod" {
+		t.Errorf("expected profile=prod field, got %v", entry.Fields)
+	}
+
+	// the base logger must not have been mutated by With
+	buf.Reset()
+	base.Info("unrelated")
+	var baseEntry jsonEntry
+	if err := json.Unmarshal(bytes.TrimRight(buf.Bytes(), "\n"), &baseEntry); err != nil {
+		t.Fatalf("unmarshalling base entry: %v", err)
+	}
+	if len(baseEntry.Fields) != 0 {
+		t.Errorf("expected the base logger to remain unaffected by With, got fields %v", baseEntry.Fields)
+	}
+}
@@ -0,0 +1,94 @@
+package clog
+
+// LevelFilter wraps a Logger and drops any entry below minLevel, so the
+// "--log-level" flag can be applied without every Logger implementation
+// having to know about it.
+type LevelFilter struct {
+	next     Logger
+	minLevel LogLevel
+}
+
+// NewLevelFilter creates a Logger that forwards entries at minLevel or
+// above to next, and silently drops the rest.
+func NewLevelFilter(minLevel LogLevel, next Logger) *LevelFilter {
+	return &LevelFilter{next: next, minLevel: minLevel}
+}
+
+func (l *LevelFilter) allowed(level LogLevel) bool {
+	return level >= l.minLevel
+}
+
+// Log sends a log entry with the specified level
+func (l *LevelFilter) Log(level LogLevel, v ...interface{}) {
+	if l.allowed(level) {
+		l.next.Log(level, v...)
+	}
+}
+
+// Logf sends a log entry with the specified level
+func (l *LevelFilter) Logf(level LogLevel, format string, v ...interface{}) {
+	if l.allowed(level) {
+		l.next.Logf(level, format, v...)
+	}
+}
+
+// Debug sends debugging information
+func (l *LevelFilter) Debug(v ...interface{}) {
+	if l.allowed(DebugLevel) {
+		l.next.Debug(v...)
+	}
+}
+
+// Debugf sends debugging information
+func (l *LevelFilter) Debugf(format string, v ...interface{}) {
+	if l.allowed(DebugLevel) {
+		l.next.Debugf(format, v...)
+	}
+}
+
+// Info logs some noticeable information
+func (l *LevelFilter) Info(v ...interface{}) {
+	if l.allowed(InfoLevel) {
+		l.next.Info(v...)
+	}
+}
+
+// Infof logs some noticeable information
+func (l *LevelFilter) Infof(format string, v ...interface{}) {
+	if l.allowed(InfoLevel) {
+		l.next.Infof(format, v...)
+	}
+}
+
+// Warning send some important message to the console
+func (l *LevelFilter) Warning(v ...interface{}) {
+	if l.allowed(WarningLevel) {
+		l.next.Warning(v...)
+	}
+}
+
+// Warningf send some important message to the console
+func (l *LevelFilter) Warningf(format string, v ...interface{}) {
+	if l.allowed(WarningLevel) {
+		l.next.Warningf(format, v...)
+	}
+}
+
+// Error sends error information to the console
+func (l *LevelFilter) Error(v ...interface{}) {
+	if l.allowed(ErrorLevel) {
+		l.next.Error(v...)
+	}
+}
+
+// Errorf sends error information to the console
+func (l *LevelFilter) Errorf(format string, v ...interface{}) {
+	if l.allowed(ErrorLevel) {
+		l.next.Errorf(format, v...)
+	}
+}
+
+// Verify interface
+var (
+	_ Logger = &LevelFilter{}
+)
@@ -0,0 +1,29 @@
+package clog
+
+import "testing"
+
+func TestLevelFilterDropsBelowMinLevel(t *testing.T) {
+	rec := &recordingLog{}
+	filter := NewLevelFilter(WarningLevel, rec)
+
+	filter.Debug("ignored")
+	filter.Info("ignored")
+	filter.Warning("kept")
+	filter.Error("kept")
+
+	if len(rec.levels) != 2 || rec.levels[0] != WarningLevel || rec.levels[1] != ErrorLevel {
+		t.Errorf("expected only Warning and Error to pass through, got %v", rec.levels)
+	}
+}
+
+func TestLevelFilterLogAndLogf(t *testing.T) {
+	rec := &recordingLog{}
+	filter := NewLevelFilter(InfoLevel, rec)
+
+	filter.Log(DebugLevel, "ignored")
+	filter.Logf(InfoLevel, "kept %d", 1)
+
+	if len(rec.levels) != 1 || rec.levels[0] != InfoLevel {
+		t.Errorf("expected only the Info entry to pass through, got %v", rec.levels)
+	}
+}
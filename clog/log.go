@@ -36,6 +36,12 @@ var (
 	// default to null logger for tests
 	defaultLog    Logger    = &NullLog{}
 	defaultOutput io.Writer = os.Stdout
+
+	// currentLevel/levelSet track the level passed to the last SetLevel
+	// call, so SetDefaultLogger can re-apply it to whatever logger
+	// becomes the new default instead of silently discarding it.
+	currentLevel LogLevel
+	levelSet     bool
 )
 
 func getLevelName(level LogLevel) string {
@@ -53,11 +59,38 @@ func getLevelName(level LogLevel) string {
 	}
 }
 
-// SetDefaultLogger sets the logger when using the package methods
+// SetDefaultLogger sets the logger when using the package methods. If
+// SetLevel was previously called, its level is re-applied to log so
+// switching loggers (e.g. from console to JSON) doesn't silently lift
+// the "--log-level" restriction.
 func SetDefaultLogger(log Logger) {
+	if levelSet {
+		log = NewLevelFilter(currentLevel, unwrapLevelFilter(log))
+	}
 	defaultLog = log
 }
 
+// SetLevel restricts the default logger to entries at level or above,
+// wrapping whatever logger is currently set. Package-level calls like
+// Info and Warning go through it transparently. Calling SetLevel again
+// replaces the previous restriction rather than stacking another filter
+// on top of it.
+func SetLevel(level LogLevel) {
+	currentLevel = level
+	levelSet = true
+	defaultLog = NewLevelFilter(level, unwrapLevelFilter(defaultLog))
+}
+
+// unwrapLevelFilter returns the logger wrapped by log if log is itself a
+// *LevelFilter, so a new filter can replace an existing one instead of
+// nesting around it.
+func unwrapLevelFilter(log Logger) Logger {
+	if filter, ok := log.(*LevelFilter); ok {
+		return filter.next
+	}
+	return log
+}
+
 // SetOutput sets the default output of the current logger
 func SetOutput(w io.Writer) {
 	defaultOutput = w
@@ -0,0 +1,74 @@
+package clog
+
+import "testing"
+
+// recordingLog records the level of every entry it receives, so tests
+// can assert on what got through a filter without depending on output
+// formatting.
+type recordingLog struct {
+	levels []LogLevel
+}
+
+func (l *recordingLog) Log(level LogLevel, v ...interface{}) { l.levels = append(l.levels, level) }
+func (l *recordingLog) Logf(level LogLevel, format string, v ...interface{}) {
+	l.levels = append(l.levels, level)
+}
+func (l *recordingLog) Debug(v ...interface{}) { l.levels = append(l.levels, DebugLevel) }
+func (l *recordingLog) Debugf(format string, v ...interface{}) {
+	l.levels = append(l.levels, DebugLevel)
+}
+func (l *recordingLog) Info(v ...interface{})                 { l.levels = append(l.levels, InfoLevel) }
+func (l *recordingLog) Infof(format string, v ...interface{}) { l.levels = append(l.levels, InfoLevel) }
+func (l *recordingLog) Warning(v ...interface{})              { l.levels = append(l.levels, WarningLevel) }
+func (l *recordingLog) Warningf(format string, v ...interface{}) {
+	l.levels = append(l.levels, WarningLevel)
+}
+func (l *recordingLog) Error(v ...interface{}) { l.levels = append(l.levels, ErrorLevel) }
+func (l *recordingLog) Errorf(format string, v ...interface{}) {
+	l.levels = append(l.levels, ErrorLevel)
+}
+
+var _ Logger = &recordingLog{}
+
+// resetDefaultLogger saves the package-level logger state and returns a
+// function that restores it, so tests exercising SetLevel/SetDefaultLogger
+// don't leak state into each other or into unrelated tests.
+func resetDefaultLogger(t *testing.T) func() {
+	t.Helper()
+	savedLog, savedLevel, savedLevelSet := defaultLog, currentLevel, levelSet
+	return func() {
+		defaultLog, currentLevel, levelSet = savedLog, savedLevel, savedLevelSet
+	}
+}
+
+func TestSetLevelReplacesRatherThanStacks(t *testing.T) {
+	defer resetDefaultLogger(t)()
+
+	rec := &recordingLog{}
+	SetDefaultLogger(rec)
+
+	SetLevel(ErrorLevel)
+	SetLevel(DebugLevel) // looser: should undo the ErrorLevel restriction
+
+	Debug("hello")
+	if len(rec.levels) != 1 || rec.levels[0] != DebugLevel {
+		t.Errorf("expected the looser SetLevel to let Debug through, got %v", rec.levels)
+	}
+}
+
+func TestSetDefaultLoggerPreservesLevel(t *testing.T) {
+	defer resetDefaultLogger(t)()
+
+	SetDefaultLogger(&recordingLog{})
+	SetLevel(WarningLevel)
+
+	rec := &recordingLog{}
+	SetDefaultLogger(rec)
+
+	Debug("should be filtered")
+	Warning("should pass")
+
+	if len(rec.levels) != 1 || rec.levels[0] != WarningLevel {
+		t.Errorf("expected the WarningLevel restriction to survive SetDefaultLogger, got %v", rec.levels)
+	}
+}
@@ -0,0 +1,40 @@
+package clog
+
+// NullLog discards every log entry. It's the default logger, so code
+// that forgets to call SetDefaultLogger doesn't crash or spam stdout.
+type NullLog struct{}
+
+// Log discards the entry.
+func (l *NullLog) Log(level LogLevel, v ...interface{}) {}
+
+// Logf discards the entry.
+func (l *NullLog) Logf(level LogLevel, format string, v ...interface{}) {}
+
+// Debug discards the entry.
+func (l *NullLog) Debug(v ...interface{}) {}
+
+// Debugf discards the entry.
+func (l *NullLog) Debugf(format string, v ...interface{}) {}
+
+// Info discards the entry.
+func (l *NullLog) Info(v ...interface{}) {}
+
+// Infof discards the entry.
+func (l *NullLog) Infof(format string, v ...interface{}) {}
+
+// Warning discards the entry.
+func (l *NullLog) Warning(v ...interface{}) {}
+
+// Warningf discards the entry.
+func (l *NullLog) Warningf(format string, v ...interface{}) {}
+
+// Error discards the entry.
+func (l *NullLog) Error(v ...interface{}) {}
+
+// Errorf discards the entry.
+func (l *NullLog) Errorf(format string, v ...interface{}) {}
+
+// Verify interface
+var (
+	_ Logger = &NullLog{}
+)
@@ -0,0 +1,90 @@
+package clog
+
+// TeeLogger fans every log entry out to multiple sinks, e.g. a
+// colourised console logger and a JSON file logger, so a user can watch
+// the console interactively while a background collector tails
+// structured logs.
+type TeeLogger struct {
+	sinks []Logger
+}
+
+// NewTeeLogger creates a Logger that forwards every entry to each of
+// sinks, in order.
+func NewTeeLogger(sinks ...Logger) *TeeLogger {
+	return &TeeLogger{sinks: sinks}
+}
+
+// Log sends a log entry with the specified level
+func (l *TeeLogger) Log(level LogLevel, v ...interface{}) {
+	for _, sink := range l.sinks {
+		sink.Log(level, v...)
+	}
+}
+
+// Logf sends a log entry with the specified level
+func (l *TeeLogger) Logf(level LogLevel, format string, v ...interface{}) {
+	for _, sink := range l.sinks {
+		sink.Logf(level, format, v...)
+	}
+}
+
+// Debug sends debugging information
+func (l *TeeLogger) Debug(v ...interface{}) {
+	for _, sink := range l.sinks {
+		sink.Debug(v...)
+	}
+}
+
+// Debugf sends debugging information
+func (l *TeeLogger) Debugf(format string, v ...interface{}) {
+	for _, sink := range l.sinks {
+		sink.Debugf(format, v...)
+	}
+}
+
+// Info logs some noticeable information
+func (l *TeeLogger) Info(v ...interface{}) {
+	for _, sink := range l.sinks {
+		sink.Info(v...)
+	}
+}
+
+// Infof logs some noticeable information
+func (l *TeeLogger) Infof(format string, v ...interface{}) {
+	for _, sink := range l.sinks {
+		sink.Infof(format, v...)
+	}
+}
+
+// Warning send some important message to the console
+func (l *TeeLogger) Warning(v ...interface{}) {
+	for _, sink := range l.sinks {
+		sink.Warning(v...)
+	}
+}
+
+// Warningf send some important message to the console
+func (l *TeeLogger) Warningf(format string, v ...interface{}) {
+	for _, sink := range l.sinks {
+		sink.Warningf(format, v...)
+	}
+}
+
+// Error sends error information to the console
+func (l *TeeLogger) Error(v ...interface{}) {
+	for _, sink := range l.sinks {
+		sink.Error(v...)
+	}
+}
+
+// Errorf sends error information to the console
+func (l *TeeLogger) Errorf(format string, v ...interface{}) {
+	for _, sink := range l.sinks {
+		sink.Errorf(format, v...)
+	}
+}
+
+// Verify interface
+var (
+	_ Logger = &TeeLogger{}
+)
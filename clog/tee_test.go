@@ -0,0 +1,23 @@
+package clog
+
+import "testing"
+
+func TestTeeLoggerFansOutToEverySink(t *testing.T) {
+	a, b := &recordingLog{}, &recordingLog{}
+	tee := NewTeeLogger(a, b)
+
+	tee.Info("hello")
+	tee.Error("boom")
+
+	for _, sink := range []*recordingLog{a, b} {
+		if len(sink.levels) != 2 || sink.levels[0] != InfoLevel || sink.levels[1] != ErrorLevel {
+			t.Errorf("expected both entries on every sink, got %v", sink.levels)
+		}
+	}
+}
+
+func TestTeeLoggerWithNoSinks(t *testing.T) {
+	tee := NewTeeLogger()
+	// must not panic
+	tee.Info("nowhere to go")
+}
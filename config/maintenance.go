@@ -0,0 +1,118 @@
+package config
+
+import (
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/creativeprojects/resticprofile/calendar"
+	"github.com/creativeprojects/resticprofile/clog"
+)
+
+// MaintenanceWindow declares a recurring or one-shot blackout window
+// during which resticprofile-triggered runs must be skipped. Start/End
+// describe a one-shot window; Recurrence (using the same schedule syntax
+// as a profile's "schedule" entries) describes a recurring one. Profiles
+// is a list of profile or group name globs (matched with path.Match) the
+// window applies to; an empty list applies to every profile.
+type MaintenanceWindow struct {
+	Name       string        `mapstructure:"name"`
+	Start      *time.Time    `mapstructure:"start"`
+	End        *time.Time    `mapstructure:"end"`
+	Duration   time.Duration `mapstructure:"duration"`
+	Recurrence string        `mapstructure:"recurrence"`
+	Profiles   []string      `mapstructure:"profiles"`
+
+	schedule   *calendar.Event
+	compiled   bool
+	compileErr error
+}
+
+// compile parses the Recurrence field (if any) once, so repeated calls to
+// Contains don't re-parse the schedule expression. The outcome (including
+// a parse failure) is cached, so compile is safe to call from Contains on
+// every check without re-parsing each time; Contains still logs the
+// cached error on every check.
+func (m *MaintenanceWindow) compile() error {
+	if m.compiled {
+		return m.compileErr
+	}
+	m.compiled = true
+	if m.Recurrence == "" {
+		return nil
+	}
+	event, err := calendar.ParseSchedule(m.Recurrence)
+	if err != nil {
+		m.compileErr = fmt.Errorf("maintenance window %q: invalid recurrence %q: %w", m.Name, m.Recurrence, err)
+		return m.compileErr
+	}
+	m.schedule = event
+	return nil
+}
+
+// AppliesTo returns true if this window applies to the given profile or
+// group name, either because it declares no Profiles (applies to
+// everything) or because name matches one of its globs.
+func (m *MaintenanceWindow) AppliesTo(name string) bool {
+	if len(m.Profiles) == 0 {
+		return true
+	}
+	for _, glob := range m.Profiles {
+		if matched, err := path.Match(glob, name); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// Contains returns true if t falls inside this window, considering both
+// the one-shot Start/End (or Start+Duration) and the recurring schedule.
+func (m *MaintenanceWindow) Contains(t time.Time) bool {
+	if m.Start != nil {
+		end := m.End
+		if end == nil && m.Duration > 0 {
+			windowEnd := m.Start.Add(m.Duration)
+			end = &windowEnd
+		}
+		if end != nil {
+			if !t.Before(*m.Start) && t.Before(*end) {
+				return true
+			}
+		}
+	}
+
+	if err := m.compile(); err != nil {
+		clog.Errorf("%v; treating window as inactive", err)
+		return false
+	}
+	if m.schedule == nil {
+		return false
+	}
+	duration := m.Duration
+	if duration <= 0 {
+		duration = time.Minute
+	}
+	// Look back by the full duration, not just a minute: an occurrence
+	// that started anywhere up to duration ago can still contain t.
+	occurrence := m.schedule.Next(t.Add(-duration))
+	return !occurrence.IsZero() && !occurrence.After(t) && t.Before(occurrence.Add(duration))
+}
+
+// MaintenanceConfig is the top-level "maintenance" configuration section:
+// a named set of blackout windows shared by every profile and group.
+type MaintenanceConfig struct {
+	Windows []MaintenanceWindow `mapstructure:"windows"`
+}
+
+// Validate parses every window's Recurrence expression and reports the
+// first failure, so a typo in the configuration is rejected when it's
+// loaded instead of silently disabling that window's blackout
+// protection the first time Contains is checked against it.
+func (c *MaintenanceConfig) Validate() error {
+	for i := range c.Windows {
+		if err := c.Windows[i].compile(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMaintenanceWindowContainsOneShot(t *testing.T) {
+	start := time.Date(2026, time.January, 1, 10, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+	window := MaintenanceWindow{Name: "one-shot", Start: &start, End: &end}
+
+	if !window.Contains(start.Add(time.Hour)) {
+		t.Error("expected a time inside the window to be contained")
+	}
+	if window.Contains(end) {
+		t.Error("expected the end boundary to be exclusive")
+	}
+	if window.Contains(start.Add(-time.Minute)) {
+		t.Error("expected a time before the window to not be contained")
+	}
+}
+
+func TestMaintenanceWindowContainsRecurring(t *testing.T) {
+	window := MaintenanceWindow{
+		Name:       "nightly",
+		Recurrence: "0 2 * * *",
+		Duration:   time.Hour,
+	}
+
+	inside := time.Date(2026, time.January, 2, 2, 30, 0, 0, time.UTC)
+	outside := time.Date(2026, time.January, 2, 4, 0, 0, 0, time.UTC)
+
+	if !window.Contains(inside) {
+		t.Error("expected a time inside the recurring window to be contained")
+	}
+	if window.Contains(outside) {
+		t.Error("expected a time outside the recurring window to not be contained")
+	}
+}
+
+func TestMaintenanceWindowContainsInvalidRecurrence(t *testing.T) {
+	window := MaintenanceWindow{Name: "broken", Recurrence: "not a schedule"}
+
+	if window.Contains(time.Now()) {
+		t.Error("expected an invalid recurrence to never match")
+	}
+}
+
+func TestMaintenanceWindowAppliesTo(t *testing.T) {
+	everything := MaintenanceWindow{Name: "all"}
+	if !everything.AppliesTo("anything") {
+		t.Error("expected a window with no Profiles to apply to every profile")
+	}
+
+	scoped := MaintenanceWindow{Name: "scoped", Profiles: []string{"prod-*"}}
+	if !scoped.AppliesTo("prod-db") {
+		t.Error("expected prod-db to match the prod-* glob")
+	}
+	if scoped.AppliesTo("staging-db") {
+		t.Error("expected staging-db to not match the prod-* glob")
+	}
+}
+
+func TestMaintenanceConfigValidateRejectsInvalidRecurrence(t *testing.T) {
+	config := MaintenanceConfig{
+		Windows: []MaintenanceWindow{
+			{Name: "good", Recurrence: "0 2 * * *"},
+			{Name: "bad", Recurrence: "not a schedule"},
+		},
+	}
+
+	if err := config.Validate(); err == nil {
+		t.Error("expected Validate to reject a window with an invalid recurrence")
+	}
+}
+
+func TestMaintenanceConfigValidateAcceptsValidRecurrences(t *testing.T) {
+	config := MaintenanceConfig{
+		Windows: []MaintenanceWindow{
+			{Name: "good", Recurrence: "0 2 * * *"},
+		},
+	}
+
+	if err := config.Validate(); err != nil {
+		t.Errorf("expected Validate to accept a valid recurrence, got %v", err)
+	}
+}
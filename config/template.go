@@ -56,6 +56,15 @@ type TemplateInfoData struct {
 	Global, Group       PropertySet
 	Profile             ProfileInfo
 	KnownResticVersions []string
+	Maintenance         []MaintenanceWindow
+}
+
+// WithMaintenance attaches the configured maintenance windows to the
+// template data, so templates can render upcoming blackout periods
+// against a profile's schedules.
+func (t *TemplateInfoData) WithMaintenance(windows []MaintenanceWindow) *TemplateInfoData {
+	t.Maintenance = windows
+	return t
 }
 
 // ProfileSections is a helper method for templates to list SectionInfo in ProfileInfo
@@ -112,13 +121,16 @@ func (t *TemplateInfoData) GetFuncs() map[string]any {
 	}
 }
 
-// NewTemplateInfoData returns template data to render references for the specified resticVersion
-func NewTemplateInfoData(resticVersion string) *TemplateInfoData {
-	return &TemplateInfoData{
+// NewTemplateInfoData returns template data to render references for the
+// specified resticVersion, with maintenance attaching the configured
+// blackout windows so the reference can document them alongside a
+// profile's schedules.
+func NewTemplateInfoData(resticVersion string, maintenance []MaintenanceWindow) *TemplateInfoData {
+	return (&TemplateInfoData{
 		DefaultData:         templates.NewDefaultData(nil),
 		Global:              NewGlobalInfo(),
 		Group:               NewGroupInfo(),
 		Profile:             NewProfileInfoForRestic(resticVersion, false),
 		KnownResticVersions: restic.KnownVersions(),
-	}
+	}).WithMaintenance(maintenance)
 }
@@ -0,0 +1,77 @@
+// Package maintenance keeps track of the configured maintenance windows
+// at runtime, so the scheduler/runner can skip a triggered run that falls
+// inside one of them.
+package maintenance
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/creativeprojects/resticprofile/config"
+)
+
+// Registry is an in-memory lookup of the maintenance windows declared in
+// the "maintenance" configuration section.
+type Registry struct {
+	windows []config.MaintenanceWindow
+}
+
+// NewRegistry builds a Registry from the "maintenance" configuration
+// section, validating every window's Recurrence expression up front so a
+// typo is reported now rather than silently disabling that window's
+// blackout protection the first time it's checked.
+func NewRegistry(maintenance config.MaintenanceConfig) (*Registry, error) {
+	if err := maintenance.Validate(); err != nil {
+		return nil, err
+	}
+	return &Registry{windows: append([]config.MaintenanceWindow{}, maintenance.Windows...)}, nil
+}
+
+// List returns every configured window, in declaration order.
+func (r *Registry) List() []config.MaintenanceWindow {
+	return r.windows
+}
+
+// Add appends a window to the registry, returning an error if a window
+// with the same name is already registered or if window's Recurrence
+// doesn't parse, for the same reason NewRegistry validates up front: a
+// typo should be rejected now, not discovered the first time Contains
+// silently treats the window as inactive.
+func (r *Registry) Add(window config.MaintenanceWindow) error {
+	for _, existing := range r.windows {
+		if existing.Name == window.Name {
+			return fmt.Errorf("maintenance window %q already exists", window.Name)
+		}
+	}
+	candidate := config.MaintenanceConfig{Windows: []config.MaintenanceWindow{window}}
+	if err := candidate.Validate(); err != nil {
+		return err
+	}
+	r.windows = append(r.windows, window)
+	return nil
+}
+
+// Remove deletes the window with the given name, returning an error if no
+// such window is registered.
+func (r *Registry) Remove(name string) error {
+	for i, window := range r.windows {
+		if window.Name == name {
+			r.windows = append(r.windows[:i], r.windows[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("maintenance window %q not found", name)
+}
+
+// ShouldSkip returns true, and the matching window, if now falls inside a
+// window that applies to profileOrGroup. The runner calls this right
+// before triggering a scheduled run.
+func (r *Registry) ShouldSkip(now time.Time, profileOrGroup string) (bool, *config.MaintenanceWindow) {
+	for i := range r.windows {
+		window := &r.windows[i]
+		if window.AppliesTo(profileOrGroup) && window.Contains(now) {
+			return true, window
+		}
+	}
+	return false, nil
+}
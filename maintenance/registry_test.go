@@ -0,0 +1,81 @@
+package maintenance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/creativeprojects/resticprofile/config"
+)
+
+func TestNewRegistryRejectsInvalidRecurrence(t *testing.T) {
+	_, err := NewRegistry(config.MaintenanceConfig{
+		Windows: []config.MaintenanceWindow{{Name: "bad", Recurrence: "not a schedule"}},
+	})
+	if err == nil {
+		t.Error("expected NewRegistry to reject a window with an invalid recurrence")
+	}
+}
+
+func TestRegistryAddAndRemove(t *testing.T) {
+	registry, err := NewRegistry(config.MaintenanceConfig{})
+	if err != nil {
+		t.Fatalf("NewRegistry: %v", err)
+	}
+
+	if err := registry.Add(config.MaintenanceWindow{Name: "w1"}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := registry.Add(config.MaintenanceWindow{Name: "w1"}); err == nil {
+		t.Error("expected Add to reject a duplicate name")
+	}
+	if len(registry.List()) != 1 {
+		t.Fatalf("expected 1 window, got %d", len(registry.List()))
+	}
+
+	if err := registry.Remove("w1"); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+	if err := registry.Remove("w1"); err == nil {
+		t.Error("expected Remove to fail for an already-removed window")
+	}
+}
+
+func TestRegistryAddRejectsInvalidRecurrence(t *testing.T) {
+	registry, err := NewRegistry(config.MaintenanceConfig{})
+	if err != nil {
+		t.Fatalf("NewRegistry: %v", err)
+	}
+
+	if err := registry.Add(config.MaintenanceWindow{Name: "bad", Recurrence: "not a schedule"}); err == nil {
+		t.Error("expected Add to reject a window with an invalid recurrence")
+	}
+	if len(registry.List()) != 0 {
+		t.Error("expected the rejected window to not be added to the registry")
+	}
+}
+
+func TestRegistryShouldSkip(t *testing.T) {
+	start := time.Date(2026, time.January, 1, 10, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	registry, err := NewRegistry(config.MaintenanceConfig{
+		Windows: []config.MaintenanceWindow{
+			{Name: "prod-blackout", Start: &start, End: &end, Profiles: []string{"prod-*"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewRegistry: %v", err)
+	}
+
+	skip, window := registry.ShouldSkip(start.Add(30*time.Minute), "prod-db")
+	if !skip || window == nil || window.Name != "prod-blackout" {
+		t.Error("expected prod-db to be skipped during the blackout window")
+	}
+
+	if skip, _ := registry.ShouldSkip(start.Add(30*time.Minute), "staging-db"); skip {
+		t.Error("expected staging-db to not be affected by the prod-* window")
+	}
+
+	if skip, _ := registry.ShouldSkip(end.Add(time.Minute), "prod-db"); skip {
+		t.Error("expected prod-db to not be skipped after the window ends")
+	}
+}
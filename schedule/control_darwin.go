@@ -0,0 +1,82 @@
+//go:build darwin
+
+package schedule
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/creativeprojects/resticprofile/calendar"
+)
+
+// launchdHandler is the Handler implementation backed by launchd user
+// agents.
+type launchdHandler struct {
+	configDir string
+}
+
+// NewHandler returns the Handler for this platform's scheduler backend.
+// configDir is where pause state is persisted, so it should match the
+// directory the profile's configuration was loaded from.
+func NewHandler(configDir string) Handler {
+	return &launchdHandler{configDir: configDir}
+}
+
+func launchdLabel(profile string) string {
+	return fmt.Sprintf("local.resticprofile.backup.%s", profile)
+}
+
+func launchdTarget(profile string) string {
+	return fmt.Sprintf("gui/%d/%s", os.Getuid(), launchdLabel(profile))
+}
+
+func plistPath(profile string) string {
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, "Library", "LaunchAgents", launchdLabel(profile)+".plist")
+}
+
+// Pause unloads profile's launchd job without removing its plist, and
+// records the profile as paused so status output can tell it apart from
+// an installed, active schedule.
+func (h *launchdHandler) Pause(profile string) error {
+	if err := runLaunchctl("bootout", launchdTarget(profile)); err != nil {
+		return err
+	}
+	return SetPaused(h.configDir, profile, true)
+}
+
+// Resume reloads profile's launchd job from its plist and clears its
+// paused state.
+func (h *launchdHandler) Resume(profile string) error {
+	if err := runLaunchctl("bootstrap", fmt.Sprintf("gui/%d", os.Getuid()), plistPath(profile)); err != nil {
+		return err
+	}
+	return SetPaused(h.configDir, profile, false)
+}
+
+// TriggerNow starts profile's launchd job immediately.
+func (h *launchdHandler) TriggerNow(profile string) error {
+	return runLaunchctl("kickstart", "-k", launchdTarget(profile))
+}
+
+// NextRuns returns up to n upcoming fire times for profile's schedule.
+func (h *launchdHandler) NextRuns(profile string, events []*calendar.Event, n int) ([]time.Time, error) {
+	return nextRuns(events, time.Now(), n), nil
+}
+
+// IsPaused reports whether profile was disabled by a previous call to
+// Pause.
+func (h *launchdHandler) IsPaused(profile string) (bool, error) {
+	return IsPaused(h.configDir, profile)
+}
+
+func runLaunchctl(args ...string) error {
+	cmd := exec.Command("launchctl", args...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("launchctl %v: %w: %s", args, err, output)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+//go:build !darwin && !windows
+
+package schedule
+
+import (
+	"fmt"
+	"os/exec"
+	"time"
+
+	"github.com/creativeprojects/resticprofile/calendar"
+)
+
+// systemdHandler is the Handler implementation backed by systemd user
+// timers and services.
+type systemdHandler struct {
+	configDir string
+}
+
+// NewHandler returns the Handler for this platform's scheduler backend.
+// configDir is where pause state is persisted, so it should match the
+// directory the profile's configuration was loaded from.
+func NewHandler(configDir string) Handler {
+	return &systemdHandler{configDir: configDir}
+}
+
+// timerUnitName returns the systemd timer unit resticprofile installs
+// for a profile's schedule.
+func timerUnitName(profile string) string {
+	return fmt.Sprintf("resticprofile-backup@%s.timer", profile)
+}
+
+func serviceUnitName(profile string) string {
+	return fmt.Sprintf("resticprofile-backup@%s.service", profile)
+}
+
+// Pause disables profile's systemd timer without removing it, and
+// records the profile as paused so status output can tell it apart from
+// an installed, active schedule.
+func (h *systemdHandler) Pause(profile string) error {
+	if err := runSystemctl("disable", "--now", timerUnitName(profile)); err != nil {
+		return err
+	}
+	return SetPaused(h.configDir, profile, true)
+}
+
+// Resume re-enables profile's systemd timer and clears its paused state.
+func (h *systemdHandler) Resume(profile string) error {
+	if err := runSystemctl("enable", "--now", timerUnitName(profile)); err != nil {
+		return err
+	}
+	return SetPaused(h.configDir, profile, false)
+}
+
+// TriggerNow starts profile's service immediately, bypassing its timer.
+func (h *systemdHandler) TriggerNow(profile string) error {
+	return runSystemctl("start", serviceUnitName(profile))
+}
+
+// NextRuns returns up to n upcoming fire times for profile's schedule.
+func (h *systemdHandler) NextRuns(profile string, events []*calendar.Event, n int) ([]time.Time, error) {
+	return nextRuns(events, time.Now(), n), nil
+}
+
+// IsPaused reports whether profile was disabled by a previous call to
+// Pause.
+func (h *systemdHandler) IsPaused(profile string) (bool, error) {
+	return IsPaused(h.configDir, profile)
+}
+
+func runSystemctl(args ...string) error {
+	cmd := exec.Command("systemctl", append([]string{"--user"}, args...)...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("systemctl %v: %w: %s", args, err, output)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+//go:build windows
+
+package schedule
+
+import (
+	"fmt"
+	"os/exec"
+	"time"
+
+	"github.com/creativeprojects/resticprofile/calendar"
+	"github.com/creativeprojects/resticprofile/constants"
+)
+
+// schtasksHandler is the Handler implementation backed by the Windows
+// Task Scheduler.
+type schtasksHandler struct {
+	configDir string
+}
+
+// NewHandler returns the Handler for this platform's scheduler backend.
+// configDir is where pause state is persisted, so it should match the
+// directory the profile's configuration was loaded from.
+func NewHandler(configDir string) Handler {
+	return &schtasksHandler{configDir: configDir}
+}
+
+// taskName returns the Task Scheduler task name resticprofile installed
+// for a profile's schedule.
+func taskName(profile string) string {
+	return fmt.Sprintf("%s backup@%s", constants.ApplicationName, profile)
+}
+
+// Pause disables profile's scheduled task without removing it, and
+// records the profile as paused so status output can tell it apart from
+// an installed, active schedule.
+func (h *schtasksHandler) Pause(profile string) error {
+	if err := runSchtasks("/Change", "/TN", taskName(profile), "/Disable"); err != nil {
+		return err
+	}
+	return SetPaused(h.configDir, profile, true)
+}
+
+// Resume re-enables a task previously disabled with Pause and clears its
+// paused state.
+func (h *schtasksHandler) Resume(profile string) error {
+	if err := runSchtasks("/Change", "/TN", taskName(profile), "/Enable"); err != nil {
+		return err
+	}
+	return SetPaused(h.configDir, profile, false)
+}
+
+// TriggerNow runs profile's scheduled task immediately.
+func (h *schtasksHandler) TriggerNow(profile string) error {
+	return runSchtasks("/Run", "/TN", taskName(profile))
+}
+
+// NextRuns returns up to n upcoming fire times for profile's schedule.
+func (h *schtasksHandler) NextRuns(profile string, events []*calendar.Event, n int) ([]time.Time, error) {
+	return nextRuns(events, time.Now(), n), nil
+}
+
+// IsPaused reports whether profile was disabled by a previous call to
+// Pause.
+func (h *schtasksHandler) IsPaused(profile string) (bool, error) {
+	return IsPaused(h.configDir, profile)
+}
+
+func runSchtasks(args ...string) error {
+	cmd := exec.Command("schtasks", args...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("schtasks %v: %w: %s", args, err, output)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/creativeprojects/resticprofile/calendar"
+)
+
+// Handler is the contract each platform scheduler backend (systemd on
+// Linux, launchd on macOS, Task Scheduler on Windows) implements to let
+// resticprofile control a profile's already-installed scheduled job.
+type Handler interface {
+	// Pause disables profile's scheduled job without removing it.
+	Pause(profile string) error
+	// Resume re-enables a job previously disabled with Pause.
+	Resume(profile string) error
+	// TriggerNow starts profile's job immediately, outside of its normal
+	// schedule.
+	TriggerNow(profile string) error
+	// NextRuns returns up to n upcoming fire times for profile's
+	// schedule.
+	NextRuns(profile string, events []*calendar.Event, n int) ([]time.Time, error)
+	// IsPaused reports whether profile was disabled by a previous call
+	// to Pause, so status output can distinguish "installed but paused"
+	// from "installed and active".
+	IsPaused(profile string) (bool, error)
+}
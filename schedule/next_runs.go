@@ -0,0 +1,45 @@
+package schedule
+
+import (
+	"sort"
+	"time"
+
+	"github.com/creativeprojects/resticprofile/calendar"
+)
+
+// maxNextRunsLookAhead bounds how far into the future NextRuns will sweep
+// looking for upcoming occurrences, so a schedule that fires rarely (or
+// never again) doesn't scan forever.
+const maxNextRunsLookAhead = 4 * 365 * 24 * time.Hour
+
+// nextRuns computes up to n upcoming fire times across every event in
+// events, merged and sorted, starting from now. Every platform's
+// NextRuns (satisfying Handler) delegates here, so the preview is the
+// same regardless of which backend actually installed the job.
+func nextRuns(events []*calendar.Event, now time.Time, n int) []time.Time {
+	if n <= 0 || len(events) == 0 {
+		return nil
+	}
+
+	var occurrences []time.Time
+	limit := now.Add(maxNextRunsLookAhead)
+	windowStart, window := now, 24*time.Hour
+
+	for len(occurrences) < n && windowStart.Before(limit) {
+		windowEnd := windowStart.Add(window)
+		if windowEnd.After(limit) {
+			windowEnd = limit
+		}
+		for _, event := range events {
+			occurrences = append(occurrences, event.GetAllInBetween(windowStart, windowEnd)...)
+		}
+		windowStart = windowEnd
+		window *= 2
+	}
+
+	sort.Slice(occurrences, func(i, j int) bool { return occurrences[i].Before(occurrences[j]) })
+	if len(occurrences) > n {
+		occurrences = occurrences[:n]
+	}
+	return occurrences
+}
@@ -0,0 +1,60 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/creativeprojects/resticprofile/calendar"
+)
+
+func TestNextRunsMergesAndSortsAcrossEvents(t *testing.T) {
+	daily, err := calendar.ParseCron("0 9 * * *")
+	if err != nil {
+		t.Fatalf("ParseCron: %v", err)
+	}
+	weekly, err := calendar.ParseCron("0 18 * * 1")
+	if err != nil {
+		t.Fatalf("ParseCron: %v", err)
+	}
+
+	now := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	runs := nextRuns([]*calendar.Event{daily, weekly}, now, 3)
+
+	if len(runs) != 3 {
+		t.Fatalf("expected 3 occurrences, got %d: %v", len(runs), runs)
+	}
+	for i := 1; i < len(runs); i++ {
+		if runs[i].Before(runs[i-1]) {
+			t.Errorf("occurrences not sorted: %s before %s", runs[i-1], runs[i])
+		}
+	}
+}
+
+func TestNextRunsEmptyInputs(t *testing.T) {
+	now := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	if runs := nextRuns(nil, now, 3); runs != nil {
+		t.Errorf("expected no occurrences for no events, got %v", runs)
+	}
+
+	daily, err := calendar.ParseCron("0 9 * * *")
+	if err != nil {
+		t.Fatalf("ParseCron: %v", err)
+	}
+	if runs := nextRuns([]*calendar.Event{daily}, now, 0); runs != nil {
+		t.Errorf("expected no occurrences when n is 0, got %v", runs)
+	}
+}
+
+func TestNextRunsLimitsToN(t *testing.T) {
+	daily, err := calendar.ParseCron("0 9 * * *")
+	if err != nil {
+		t.Fatalf("ParseCron: %v", err)
+	}
+
+	now := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	runs := nextRuns([]*calendar.Event{daily}, now, 2)
+	if len(runs) != 2 {
+		t.Fatalf("expected 2 occurrences, got %d: %v", len(runs), runs)
+	}
+}
@@ -1,30 +1,29 @@
-//+build !darwin,!windows
+//go:build !darwin && !windows
+// +build !darwin,!windows
 
 package schedule
 
 import (
-	"errors"
 	"fmt"
-	"os"
-	"os/exec"
 
 	"github.com/creativeprojects/resticprofile/calendar"
 )
 
+// loadSchedules parses a list of schedule expressions, each either a
+// systemd calendar specification, a standard cron expression, or a
+// "HH:MM" time of day, into the calendar.Event values shared by every
+// platform schedule generator.
 func loadSchedules(schedules []string) ([]*calendar.Event, error) {
 	events := make([]*calendar.Event, 0, len(schedules))
 	for index, schedule := range schedules {
 		if schedule == "" {
-			return events, errors.New("empty schedule")
+			return events, fmt.Errorf("schedule %d: empty schedule", index+1)
 		}
-		fmt.Printf("\nAnalyzing schedule %d/%d\n========================\n", index+1, len(schedules))
-		cmd := exec.Command("systemd-analyze", "calendar", schedule)
-		cmd.Stdout = os.Stdout
-		cmd.Stderr = os.Stderr
-		err := cmd.Run()
+		event, err := calendar.ParseSchedule(schedule)
 		if err != nil {
-			return events, err
+			return events, fmt.Errorf("schedule %d (%q): %w", index+1, schedule, err)
 		}
+		events = append(events, event)
 	}
 	return events, nil
 }
@@ -0,0 +1,72 @@
+package schedule
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const pauseStateFileName = "schedule-pause-state.json"
+
+// pauseState is the JSON document persisted under the configuration
+// directory that records which profiles currently have their schedule
+// paused, so "schedule status" can tell "installed but paused" apart
+// from "installed and active".
+type pauseState struct {
+	Paused map[string]bool `json:"paused"`
+}
+
+func pauseStatePath(configDir string) string {
+	return filepath.Join(configDir, pauseStateFileName)
+}
+
+func loadPauseState(configDir string) (*pauseState, error) {
+	state := &pauseState{Paused: map[string]bool{}}
+
+	data, err := os.ReadFile(pauseStatePath(configDir))
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading schedule pause state: %w", err)
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("reading schedule pause state: %w", err)
+	}
+	if state.Paused == nil {
+		state.Paused = map[string]bool{}
+	}
+	return state, nil
+}
+
+func (s *pauseState) save(configDir string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(pauseStatePath(configDir), data, 0o644)
+}
+
+// SetPaused persists whether profile's scheduled job is paused.
+func SetPaused(configDir, profile string, paused bool) error {
+	state, err := loadPauseState(configDir)
+	if err != nil {
+		return err
+	}
+	if paused {
+		state.Paused[profile] = true
+	} else {
+		delete(state.Paused, profile)
+	}
+	return state.save(configDir)
+}
+
+// IsPaused reports whether profile's scheduled job has been paused.
+func IsPaused(configDir, profile string) (bool, error) {
+	state, err := loadPauseState(configDir)
+	if err != nil {
+		return false, err
+	}
+	return state.Paused[profile], nil
+}
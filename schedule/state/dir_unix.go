@@ -0,0 +1,19 @@
+//go:build !windows
+
+package state
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// baseDir returns the directory resticprofile's schedule state is kept
+// in, following the XDG base directory spec: $XDG_STATE_HOME if set,
+// otherwise ~/.local/state.
+func baseDir() string {
+	if dir := os.Getenv("XDG_STATE_HOME"); dir != "" {
+		return filepath.Join(dir, "resticprofile")
+	}
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".local", "state", "resticprofile")
+}
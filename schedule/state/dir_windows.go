@@ -0,0 +1,18 @@
+//go:build windows
+
+package state
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// baseDir returns the directory resticprofile's schedule state is kept
+// in: %LOCALAPPDATA% if set, otherwise %USERPROFILE%\AppData\Local.
+func baseDir() string {
+	if dir := os.Getenv("LOCALAPPDATA"); dir != "" {
+		return filepath.Join(dir, "resticprofile")
+	}
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, "AppData", "Local", "resticprofile")
+}
@@ -0,0 +1,108 @@
+package state
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Period is a "run at most every N" schedule gate: it says whether a
+// profile is due to run, given when it last completed successfully.
+type Period interface {
+	Due(lastSuccess, now time.Time) bool
+}
+
+type intervalPeriod struct{ interval time.Duration }
+
+func (p intervalPeriod) Due(lastSuccess, now time.Time) bool {
+	return lastSuccess.IsZero() || now.Sub(lastSuccess) >= p.interval
+}
+
+type recurrencePeriod struct{ recurrence Recurrence }
+
+func (p recurrencePeriod) Due(lastSuccess, now time.Time) bool {
+	if !p.recurrence.Matches(now) {
+		return false
+	}
+	return lastSuccess.IsZero() || !isSameDay(lastSuccess, now)
+}
+
+func isSameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+var periodKeywords = map[string]Period{
+	"hourly":  intervalPeriod{time.Hour},
+	"daily":   recurrencePeriod{Daily()},
+	"weekly":  recurrencePeriod{Weekly(time.Sunday)},
+	"monthly": recurrencePeriod{Monthly(1)},
+	"yearly":  recurrencePeriod{Yearly(1)},
+}
+
+// ParsePeriod parses a "period" directive: a plain duration ("6h", "3d",
+// "2w"), one of the "hourly"/"daily"/"weekly"/"monthly"/"yearly"
+// keywords, or "divisible:n[:base]" for the Divisible combinator.
+func ParsePeriod(expr string) (Period, error) {
+	text := strings.TrimSpace(expr)
+
+	if period, ok := periodKeywords[strings.ToLower(text)]; ok {
+		return period, nil
+	}
+
+	if rest, ok := strings.CutPrefix(strings.ToLower(text), "divisible:"); ok {
+		parts := strings.Split(rest, ":")
+		n, err := strconv.Atoi(parts[0])
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("period %q: invalid divisible factor", expr)
+		}
+		base := 0
+		if len(parts) == 2 {
+			base, err = strconv.Atoi(parts[1])
+			if err != nil {
+				return nil, fmt.Errorf("period %q: invalid divisible base", expr)
+			}
+		}
+		return recurrencePeriod{Divisible(n, base)}, nil
+	}
+
+	interval, err := parseDuration(text)
+	if err != nil {
+		return nil, fmt.Errorf("period %q: %w", expr, err)
+	}
+	return intervalPeriod{interval}, nil
+}
+
+// parseDuration extends time.ParseDuration with day ("d") and week ("w")
+// units, which resticprofile's period directive accepts (e.g. "3d").
+func parseDuration(text string) (time.Duration, error) {
+	if n := len(text); n > 0 {
+		switch text[n-1] {
+		case 'd':
+			days, err := strconv.ParseFloat(text[:n-1], 64)
+			if err != nil {
+				return 0, err
+			}
+			return time.Duration(days * float64(24*time.Hour)), nil
+		case 'w':
+			weeks, err := strconv.ParseFloat(text[:n-1], 64)
+			if err != nil {
+				return 0, err
+			}
+			return time.Duration(weeks * float64(7*24*time.Hour)), nil
+		}
+	}
+	return time.ParseDuration(text)
+}
+
+// Due reports whether profile is due to run under period, based on its
+// last recorded successful completion time.
+func Due(profile string, period Period, now time.Time) (bool, error) {
+	lastSuccess, err := LastSuccess(profile)
+	if err != nil {
+		return false, err
+	}
+	return period.Due(lastSuccess, now), nil
+}
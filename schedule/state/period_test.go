@@ -0,0 +1,67 @@
+package state
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParsePeriodInterval(t *testing.T) {
+	period, err := ParsePeriod("3d")
+	if err != nil {
+		t.Fatalf("ParsePeriod: %v", err)
+	}
+
+	now := time.Date(2026, time.January, 10, 12, 0, 0, 0, time.UTC)
+	lastSuccess := now.Add(-2 * 24 * time.Hour)
+	if period.Due(lastSuccess, now) {
+		t.Error("expected not due after only 2 of 3 days")
+	}
+
+	lastSuccess = now.Add(-3*24*time.Hour - time.Minute)
+	if !period.Due(lastSuccess, now) {
+		t.Error("expected due after more than 3 days")
+	}
+
+	if !period.Due(time.Time{}, now) {
+		t.Error("expected due when there is no recorded last success")
+	}
+}
+
+func TestParsePeriodDivisible(t *testing.T) {
+	period, err := ParsePeriod("divisible:3")
+	if err != nil {
+		t.Fatalf("ParsePeriod: %v", err)
+	}
+
+	eligibleDay := time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC)
+	ineligibleDay := time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	if !period.Due(time.Time{}, eligibleDay) {
+		t.Error("expected Dec 31 2026 to be due for divisible:3")
+	}
+	if period.Due(time.Time{}, ineligibleDay) {
+		t.Error("expected Jan 1 2027 to not be due for divisible:3")
+	}
+
+	// already ran today: not due again even on an eligible day
+	if period.Due(eligibleDay, eligibleDay) {
+		t.Error("expected no second run on the same eligible day")
+	}
+}
+
+func TestParsePeriodWeeklyKeyword(t *testing.T) {
+	period, err := ParsePeriod("weekly")
+	if err != nil {
+		t.Fatalf("ParsePeriod: %v", err)
+	}
+
+	sunday := time.Date(2026, time.January, 4, 0, 0, 0, 0, time.UTC)
+	monday := sunday.AddDate(0, 0, 1)
+
+	if !period.Due(time.Time{}, sunday) {
+		t.Error("expected Sunday to be due for weekly")
+	}
+	if period.Due(time.Time{}, monday) {
+		t.Error("expected Monday to not be due for weekly")
+	}
+}
@@ -0,0 +1,63 @@
+package state
+
+import "time"
+
+// Recurrence reports whether a given day is an eligible day for a
+// recurrence-based Period.
+type Recurrence interface {
+	Matches(day time.Time) bool
+}
+
+type dailyRecurrence struct{}
+
+// Daily matches every day.
+func Daily() Recurrence { return dailyRecurrence{} }
+
+func (dailyRecurrence) Matches(time.Time) bool { return true }
+
+type weeklyRecurrence struct{ weekday time.Weekday }
+
+// Weekly matches the given day of the week.
+func Weekly(weekday time.Weekday) Recurrence { return weeklyRecurrence{weekday} }
+
+func (w weeklyRecurrence) Matches(day time.Time) bool { return day.Weekday() == w.weekday }
+
+type monthlyRecurrence struct{ day int }
+
+// Monthly matches the given day of the month (1-31).
+func Monthly(day int) Recurrence { return monthlyRecurrence{day} }
+
+func (m monthlyRecurrence) Matches(day time.Time) bool { return day.Day() == m.day }
+
+type yearlyRecurrence struct{ yearday int }
+
+// Yearly matches the given day of the year (1-366).
+func Yearly(yearday int) Recurrence { return yearlyRecurrence{yearday} }
+
+func (y yearlyRecurrence) Matches(day time.Time) bool { return day.YearDay() == y.yearday }
+
+// divisibleEpoch is the fixed reference date Divisible counts days from.
+// Counting from a fixed epoch (rather than YearDay, which resets to 1
+// every January 1st regardless of where the previous year's cycle left
+// off) keeps the cycle continuous across year boundaries.
+var divisibleEpoch = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+type divisibleRecurrence struct{ n, base int }
+
+// Divisible matches every day whose day count since a fixed epoch is
+// evenly divisible by n once offset by base: (days - base) % n == 0.
+// It's the combinator behind constructions like "every 3rd day"
+// (Divisible(3, 0)) or "every other week" (Divisible(14, 0)).
+func Divisible(n, base int) Recurrence { return divisibleRecurrence{n, base} }
+
+func (d divisibleRecurrence) Matches(day time.Time) bool {
+	if d.n <= 0 {
+		return false
+	}
+	days := int(day.UTC().Sub(divisibleEpoch).Hours() / 24)
+	offset := (days - d.base) % d.n
+	if offset < 0 {
+		offset += d.n
+	}
+	return offset == 0
+}
@@ -0,0 +1,74 @@
+// Package state tracks per-profile last-success timestamps, so a
+// "period" schedule (e.g. "run at most every 6h") can tell whether a
+// profile is actually due rather than relying on the OS scheduler to
+// have fired at exactly the right moment.
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const stateFileName = "last-success.json"
+
+type fileState struct {
+	LastSuccess map[string]time.Time `json:"last_success"`
+}
+
+func filePath() string {
+	return filepath.Join(baseDir(), stateFileName)
+}
+
+func load() (*fileState, error) {
+	state := &fileState{LastSuccess: map[string]time.Time{}}
+
+	data, err := os.ReadFile(filePath())
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading schedule state: %w", err)
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("reading schedule state: %w", err)
+	}
+	if state.LastSuccess == nil {
+		state.LastSuccess = map[string]time.Time{}
+	}
+	return state, nil
+}
+
+func (s *fileState) save() error {
+	if err := os.MkdirAll(baseDir(), 0o755); err != nil {
+		return fmt.Errorf("creating schedule state directory: %w", err)
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filePath(), data, 0o644)
+}
+
+// LastSuccess returns the last recorded successful completion time for
+// profile, or the zero time if none has been recorded yet.
+func LastSuccess(profile string) (time.Time, error) {
+	state, err := load()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return state.LastSuccess[profile], nil
+}
+
+// RecordSuccess persists now as profile's last successful completion
+// time.
+func RecordSuccess(profile string, now time.Time) error {
+	state, err := load()
+	if err != nil {
+		return err
+	}
+	state.LastSuccess[profile] = now
+	return state.save()
+}
@@ -0,0 +1,53 @@
+package schedule
+
+import "testing"
+
+func TestSetPausedAndIsPaused(t *testing.T) {
+	configDir := t.TempDir()
+
+	paused, err := IsPaused(configDir, "profile1")
+	if err != nil {
+		t.Fatalf("IsPaused: %v", err)
+	}
+	if paused {
+		t.Error("expected profile1 to not be paused before SetPaused is ever called")
+	}
+
+	if err := SetPaused(configDir, "profile1", true); err != nil {
+		t.Fatalf("SetPaused: %v", err)
+	}
+	paused, err = IsPaused(configDir, "profile1")
+	if err != nil {
+		t.Fatalf("IsPaused: %v", err)
+	}
+	if !paused {
+		t.Error("expected profile1 to be paused after SetPaused(true)")
+	}
+
+	if err := SetPaused(configDir, "profile1", false); err != nil {
+		t.Fatalf("SetPaused: %v", err)
+	}
+	paused, err = IsPaused(configDir, "profile1")
+	if err != nil {
+		t.Fatalf("IsPaused: %v", err)
+	}
+	if paused {
+		t.Error("expected profile1 to not be paused after SetPaused(false)")
+	}
+}
+
+func TestIsPausedUnaffectedByOtherProfiles(t *testing.T) {
+	configDir := t.TempDir()
+
+	if err := SetPaused(configDir, "profile1", true); err != nil {
+		t.Fatalf("SetPaused: %v", err)
+	}
+
+	paused, err := IsPaused(configDir, "profile2")
+	if err != nil {
+		t.Fatalf("IsPaused: %v", err)
+	}
+	if paused {
+		t.Error("expected profile2 to be unaffected by profile1's paused state")
+	}
+}
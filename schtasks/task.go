@@ -83,6 +83,10 @@ func (t *Task) AddExecAction(action ExecAction) {
 
 func (t *Task) AddSchedules(schedules []*calendar.Event) {
 	for _, schedule := range schedules {
+		if seconds := schedule.Second.GetRangeValues(); len(seconds) > 1 || (len(seconds) == 1 && seconds[0] != 0) {
+			clog.Warningf("schedule '%s' needs second-level granularity, which the Windows Task Scheduler backend cannot represent", schedule.String())
+			continue
+		}
 		if triggerOnce, ok := schedule.AsTime(); ok {
 			// one time only
 			t.addTimeTrigger(triggerOnce)